@@ -0,0 +1,55 @@
+package main
+
+import "testing"
+
+func TestClassifyUpdate(t *testing.T) {
+	tests := []struct {
+		name    string
+		version string
+		update  string
+		want    string
+	}{
+		{"major bump", "v1.2.3", "v2.0.0", classMajor},
+		{"minor bump", "v1.2.3", "v1.3.0", classMinor},
+		{"patch bump", "v1.2.3", "v1.2.4", classPatch},
+		{"prerelease update", "v1.2.3", "v1.3.0-rc.1", classPrerelease},
+		{"invalid current version", "not-a-version", "v1.2.4", ""},
+		{"invalid update version", "v1.2.3", "not-a-version", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := module{Version: tt.version, Update: &module{Version: tt.update}}
+			if got := classifyUpdate(m); got != tt.want {
+				t.Errorf("classifyUpdate(%q -> %q) = %q, want %q", tt.version, tt.update, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClassifyUpdateNoUpdate(t *testing.T) {
+	if got := classifyUpdate(module{Version: "v1.2.3"}); got != "" {
+		t.Errorf("classifyUpdate with nil Update = %q, want \"\"", got)
+	}
+}
+
+func TestFilterByClass(t *testing.T) {
+	modules := []module{
+		{Path: "a", Class: classMajor},
+		{Path: "b", Class: classMinor},
+		{Path: "c", Class: classPatch},
+	}
+
+	got := filterByClass(modules, map[string]bool{classMinor: true, classPatch: true})
+	if len(got) != 2 || got[0].Path != "b" || got[1].Path != "c" {
+		t.Errorf("got %+v, want modules b and c", got)
+	}
+}
+
+func TestFilterByClassNilAllowed(t *testing.T) {
+	modules := []module{{Path: "a", Class: classMajor}}
+	got := filterByClass(modules, nil)
+	if len(got) != 1 || got[0].Path != "a" {
+		t.Errorf("got %+v, want modules unchanged", got)
+	}
+}
@@ -1,25 +1,46 @@
 package main
 
 import (
+	"bufio"
 	"bytes"
+	"container/list"
+	"context"
 	"encoding/json"
+	"flag"
 	"fmt"
 	"io"
 	"log"
+	"net/http"
+	"net/url"
 	"os"
 	"os/exec"
 	"sort"
+	"strconv"
 	"strings"
+	"sync"
+	"text/tabwriter"
 
 	"github.com/charmbracelet/bubbles/spinner"
 	"github.com/charmbracelet/bubbles/viewport"
 	tea "github.com/charmbracelet/bubbletea"
 
 	"github.com/muesli/termenv"
+	"github.com/sahilm/fuzzy"
+	"golang.org/x/mod/semver"
 )
 
 func main() {
-	m := newModel()
+	only := flag.String("only", "", "comma-separated update classes to show: major, minor, patch, prerelease")
+	report := flag.Bool("report", false, "print a non-interactive report of outdated modules and exit, instead of launching the TUI")
+	format := flag.String("format", "table", "report format when -report is set: json, markdown, or table")
+	indirect := flag.Bool("indirect", true, "include indirect dependencies when -report is set")
+	flag.Parse()
+
+	if *report {
+		os.Exit(runReport(*only, *format, *indirect))
+	}
+
+	m := newModel(parseClasses(*only))
 	p := tea.NewProgram(m)
 
 	p.EnterAltScreen()
@@ -36,27 +57,146 @@ func main() {
 }
 
 type model struct {
-	spinner  spinner.Model
-	viewport viewport.Model
-	color    termenv.Profile
+	spinner       spinner.Model
+	viewport      viewport.Model
+	notesViewport viewport.Model
+	logViewport   viewport.Model
+	color         termenv.Profile
 
-	builder  strings.Builder
-	ready    bool
-	modules  []module
-	cursor   int
-	updating bool
+	builder     strings.Builder
+	ready       bool
+	fullHeight  int
+	modules     []module
+	cursor      int
+	updating    bool
+	updateDone  int
+	updateTotal int
+	filtering   bool
+	filter      string
+	classFilter string
+
+	pendingQueue []module // the module currently updating, followed by those still queued behind it
+	bulkUpdate   bool     // true if the running queue came from "U" (selection), not a single "enter"
+	logLines     []string // streamed output of the running `go get`
+	cancel       context.CancelFunc
+
+	onlyClasses map[string]bool
 
 	err error
 }
 
-func newModel() *model {
+func newModel(onlyClasses map[string]bool) *model {
 	s := spinner.NewModel()
 	s.ForegroundColor = "2"
 
 	return &model{
-		color:   termenv.ColorProfile(),
-		spinner: s,
+		color:       termenv.ColorProfile(),
+		spinner:     s,
+		onlyClasses: onlyClasses,
+	}
+}
+
+// parseClasses parses a comma-separated --only flag value into the set of
+// update classes to keep. An empty value means "no filtering".
+func parseClasses(only string) map[string]bool {
+	if only == "" {
+		return nil
+	}
+
+	classes := make(map[string]bool)
+	for _, class := range strings.Split(only, ",") {
+		if class = strings.TrimSpace(class); class != "" {
+			classes[class] = true
+		}
 	}
+
+	return classes
+}
+
+// runReport prints a non-interactive report of outdated modules to stdout
+// in the given format and returns the process exit code: 1 if any module
+// remains outdated after filtering or if loading or writing the report
+// fails, 0 otherwise, so CI pipelines and pre-commit hooks can gate on it.
+func runReport(only, format string, includeIndirect bool) int {
+	modules, err := load()
+	if err != nil {
+		log.Println(err)
+		return 1
+	}
+
+	modules = filterByClass(modules, parseClasses(only))
+	if !includeIndirect {
+		modules = excludeIndirect(modules)
+	}
+
+	var write func(io.Writer, []module) error
+	switch format {
+	case "json":
+		write = writeJSONReport
+	case "markdown":
+		write = writeMarkdownReport
+	case "table":
+		write = writeTableReport
+	default:
+		log.Printf("unknown -format %q, want json, markdown, or table", format)
+		return 1
+	}
+
+	if err := write(os.Stdout, modules); err != nil {
+		log.Println(err)
+		return 1
+	}
+
+	if len(modules) > 0 {
+		return 1
+	}
+	return 0
+}
+
+// excludeIndirect drops indirectly-required modules, for teams that only
+// want to gate on the freshness of their direct dependencies.
+func excludeIndirect(modules []module) []module {
+	filtered := make([]module, 0, len(modules))
+	for _, m := range modules {
+		if !m.Indirect {
+			filtered = append(filtered, m)
+		}
+	}
+	return filtered
+}
+
+func writeJSONReport(w io.Writer, modules []module) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(modules)
+}
+
+func writeMarkdownReport(w io.Writer, modules []module) error {
+	if len(modules) == 0 {
+		_, err := fmt.Fprintln(w, "All modules are up-to-date.")
+		return err
+	}
+
+	fmt.Fprintln(w, "| Module | Version | Update | Class | Indirect |")
+	fmt.Fprintln(w, "| --- | --- | --- | --- | --- |")
+	for _, m := range modules {
+		fmt.Fprintf(w, "| %s | %s | %s | %s | %t |\n", m.Path, m.Version, m.Update.Version, m.Class, m.Indirect)
+	}
+	return nil
+}
+
+func writeTableReport(w io.Writer, modules []module) error {
+	if len(modules) == 0 {
+		_, err := fmt.Fprintln(w, "All modules are up-to-date.")
+		return err
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 2, 2, ' ', 0)
+	fmt.Fprintln(tw, "MODULE\tVERSION\tUPDATE\tCLASS\tINDIRECT")
+	for _, m := range modules {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%t\n", m.Path, m.Version, m.Update.Version, m.Class, m.Indirect)
+	}
+	return tw.Flush()
 }
 
 func (m *model) Init() tea.Cmd {
@@ -72,18 +212,139 @@ func (m *model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.err = msg.err
 		return m, tea.Quit
 	case modulesMsg:
-		m.modules = msg.modules
-	case updatedMsg:
+		m.modules = filterByClass(msg.modules, m.onlyClasses)
+		return m, tea.Batch(m.notesCmd(), vulnCmd(m.modules))
+	case updateStartedMsg:
+		m.cancel = msg.cancel
+		return m, listenForUpdate(msg.cancel, msg.lines, msg.result)
+	case logLineMsg:
+		m.logLines = append(m.logLines, msg.line)
+		m.logViewport.SetContent(strings.Join(m.logLines, "\n"))
+		m.logViewport.GotoBottom()
+		return m, listenForUpdate(msg.cancel, msg.lines, msg.result)
+	case updateStepMsg:
+		m.cancel = nil
+		m.updateDone++
+
+		if msg.err != nil {
+			m.err = msg.err
+			return m, tea.Quit
+		}
+
+		m.modules = filterByClass(msg.modules, m.onlyClasses)
+
+		if !msg.canceled && len(m.pendingQueue) > 1 {
+			m.pendingQueue = m.pendingQueue[1:]
+			m.logLines = nil
+			return m, updateCmd(m.pendingQueue)
+		}
+
 		m.updating = false
-		m.modules = msg.modules
+		m.pendingQueue = nil
+		if m.bulkUpdate {
+			m.clearSelection()
+		}
+		return m, tea.Batch(m.notesCmd(), vulnCmd(m.modules))
+	case notesMsg:
+		for i := range m.modules {
+			if m.modules[i].Path == msg.path {
+				m.modules[i].Notes = msg.notes
+				m.modules[i].NotesLoading = false
+				break
+			}
+		}
+	case vulnMsg:
+		for i := range m.modules {
+			m.modules[i].Vulns = msg.vulns[m.modules[i].Path]
+		}
 	case tea.KeyMsg:
+		if m.filtering {
+			switch key := msg.String(); key {
+			case "ctrl+c":
+				return m, tea.Quit
+			case "esc":
+				m.filtering = false
+				m.filter = ""
+			case "enter":
+				m.filtering = false
+			case "backspace":
+				if len(m.filter) > 0 {
+					m.filter = m.filter[:len(m.filter)-1]
+				}
+			default:
+				if len(key) == 1 {
+					m.filter += key
+				}
+			}
+			m.cursor = 0
+			m.fixViewport(true)
+			return m, m.notesCmd()
+		}
+
 		switch msg.String() {
 		case "ctrl+c", "q":
 			return m, tea.Quit
+		case "ctrl+x":
+			if m.updating && m.cancel != nil {
+				m.cancel()
+			}
+		case "/":
+			if !m.updating {
+				m.filtering = true
+			}
+		case "1":
+			if !m.updating {
+				m.toggleClassFilter(classMajor)
+			}
+		case "2":
+			if !m.updating {
+				m.toggleClassFilter(classMinor)
+			}
+		case "3":
+			if !m.updating {
+				m.toggleClassFilter(classPatch)
+			}
+		case "V":
+			if !m.updating {
+				m.jumpToVulnerable()
+			}
 		case "enter":
 			if !m.updating {
+				matches := m.visibleMatches()
+				if len(matches) == 0 {
+					break
+				}
+				idx := matches[m.cursor].Index
+				m.updating = true
+				queue := []module{m.modules[idx]}
+				m.updateDone, m.updateTotal = 0, len(queue)
+				m.pendingQueue, m.logLines = queue, nil
+				m.bulkUpdate = false
+				return m, updateCmd(queue)
+			}
+		case " ":
+			if !m.updating {
+				matches := m.visibleMatches()
+				if len(matches) == 0 {
+					break
+				}
+				idx := matches[m.cursor].Index
+				m.modules[idx].Selected = !m.modules[idx].Selected
+				m.cursor++
+				m.fixCursor()
+				m.fixViewport(false)
+			}
+		case "U":
+			if !m.updating {
+				queue := m.selectedModules()
+				if len(queue) == 0 {
+					break
+				}
 				m.updating = true
-				return m, updateCmd(m.modules[m.cursor])
+				m.updateDone, m.updateTotal = 0, len(queue)
+				m.pendingQueue, m.logLines = queue, nil
+				m.bulkUpdate = true
+				return m, updateCmd(queue)
 			}
 		case "down", "j":
 			if !m.updating {
@@ -108,20 +369,36 @@ func (m *model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.fixViewport(true)
 			}
 		}
+		return m, m.notesCmd()
 	case spinner.TickMsg:
 		var cmd tea.Cmd
 		m.spinner, cmd = spinner.Update(msg, m.spinner)
 		return m, cmd
 	case tea.WindowSizeMsg:
+		listWidth := msg.Width / 2
+		notesWidth := msg.Width - listWidth - 1 // 1 column for the pane separator
+
+		m.fullHeight = msg.Height - 2
+
 		if !m.ready {
 			m.viewport = viewport.Model{
-				Width:  msg.Width,
-				Height: msg.Height - 2,
+				Width:  listWidth,
+				Height: m.fullHeight,
+			}
+			m.notesViewport = viewport.Model{
+				Width:  notesWidth,
+				Height: m.fullHeight,
+			}
+			m.logViewport = viewport.Model{
+				Width: msg.Width,
 			}
 			m.ready = true
 		} else {
-			m.viewport.Width = msg.Width
-			m.viewport.Height = msg.Height - 2
+			m.viewport.Width = listWidth
+			m.viewport.Height = m.fullHeight
+			m.notesViewport.Width = notesWidth
+			m.notesViewport.Height = m.fullHeight
+			m.logViewport.Width = msg.Width
 			m.fixViewport(true)
 		}
 	}
@@ -135,20 +412,137 @@ func (m *model) View() string {
 		header = spinner.View(m.spinner) + " Loading..."
 	} else if len(m.modules) == 0 {
 		header = "All modules are up-to-date"
+	} else if m.updating {
+		header = fmt.Sprintf("Updating module %d/%d (ctrl+x to cancel) ...", m.updateDone+1, m.updateTotal)
+		body = m.body()
+	} else if m.filtering {
+		header = fmt.Sprintf("Filter: %s_", m.filter)
+		body = m.body()
 	} else {
-		header = fmt.Sprintf("Press enter to update [%d/%d]", m.cursor+1, len(m.modules))
-		m.viewport.SetContent(m.content())
-		body = viewport.View(m.viewport)
+		matches := m.visibleMatches()
+		header = fmt.Sprintf("Press enter to update, space to select, U to update selection, / to filter, 1/2/3 to filter by class [%d/%d]", m.cursor+1, len(matches))
+		if m.classFilter != "" {
+			header += fmt.Sprintf(" (class: %s)", m.classFilter)
+		}
+		body = m.body()
 	}
 	footer = "(press 'q' to quit)"
+	if n := countVulnerable(m.modules); n > 0 {
+		footer = fmt.Sprintf("%d vulnerable module(s), press 'V' to jump to them (press 'q' to quit)", n)
+	}
 
 	return fmt.Sprintf("%s\n%s\n%s", header, body, footer)
 }
 
+// countVulnerable returns how many modules have at least one known
+// vulnerability.
+func countVulnerable(modules []module) int {
+	n := 0
+	for _, m := range modules {
+		if len(m.Vulns) > 0 {
+			n++
+		}
+	}
+	return n
+}
+
+// body renders the module list and the release-notes pane for the module
+// under the cursor side by side.
+func (m *model) body() string {
+	if m.updating {
+		return m.updatingBody()
+	}
+
+	m.viewport.Height = m.fullHeight
+	m.viewport.SetContent(m.content())
+	m.notesViewport.SetContent(m.notesContent())
+
+	return joinPanes(viewport.View(m.viewport), viewport.View(m.notesViewport), m.viewport.Width)
+}
+
+// updatingBody stacks the module list above a log pane streaming the
+// output of the running `go get`, shrinking the list to make room.
+func (m *model) updatingBody() string {
+	logHeight := m.fullHeight / 3
+	listHeight := m.fullHeight - logHeight - 1 // 1 row for the separator
+
+	m.viewport.Height = listHeight
+	m.viewport.SetContent(m.content())
+
+	m.logViewport.Height = logHeight
+	m.logViewport.SetContent(strings.Join(m.logLines, "\n"))
+
+	separator := strings.Repeat("-", m.viewport.Width+1+m.notesViewport.Width)
+
+	return viewport.View(m.viewport) + "\n" + separator + "\n" + viewport.View(m.logViewport)
+}
+
+func joinPanes(left, right string, leftWidth int) string {
+	leftLines := strings.Split(left, "\n")
+	rightLines := strings.Split(right, "\n")
+
+	lines := len(leftLines)
+	if len(rightLines) > lines {
+		lines = len(rightLines)
+	}
+
+	var b strings.Builder
+	for i := 0; i < lines; i++ {
+		var l, r string
+		if i < len(leftLines) {
+			l = leftLines[i]
+		}
+		if i < len(rightLines) {
+			r = rightLines[i]
+		}
+
+		fmt.Fprintf(&b, "%-*s|%s\n", leftWidth, l, r)
+	}
+
+	return b.String()
+}
+
+func (m *model) notesContent() string {
+	matches := m.visibleMatches()
+	if len(matches) == 0 || m.cursor >= len(matches) {
+		return ""
+	}
+
+	module := m.modules[matches[m.cursor].Index]
+	switch {
+	case module.NotesLoading:
+		return spinner.View(m.spinner) + " fetching release notes..."
+	case module.Notes != "":
+		return module.Notes
+	default:
+		return "no notes available"
+	}
+}
+
+// notesCmd kicks off a fetch of the release notes for the module under the
+// cursor, unless they are already cached or already in flight.
+func (m *model) notesCmd() tea.Cmd {
+	matches := m.visibleMatches()
+	if len(matches) == 0 || m.cursor >= len(matches) {
+		return nil
+	}
+
+	idx := matches[m.cursor].Index
+	if m.modules[idx].Notes != "" || m.modules[idx].NotesLoading {
+		return nil
+	}
+
+	m.modules[idx].NotesLoading = true
+	return releasesCmd(m.modules[idx])
+}
+
 func (m *model) content() string {
 	defer m.builder.Reset()
 
-	for i, module := range m.modules {
+	matches := m.visibleMatches()
+	for i, match := range matches {
+		module := m.modules[match.Index]
+
 		cursor := " "
 		if m.cursor == i {
 			cursor = termenv.String(">").Foreground(m.color.Color("1")).String()
@@ -157,25 +551,164 @@ func (m *model) content() string {
 			}
 		}
 
+		checkbox := "[ ]"
+		if module.Selected {
+			checkbox = "[x]"
+		}
+
 		indirect := ""
 		if module.Indirect {
 			indirect = "// indirect"
 		}
 
+		class := ""
+		if module.Class != "" {
+			class = colorizeClass(module.Class, m.color)
+		}
+
+		vulnMark := " "
+		if len(module.Vulns) > 0 {
+			vulnMark = termenv.String("!").Foreground(m.color.Color("1")).String()
+		}
+
 		m.builder.WriteString(fmt.Sprintf(
-			"%s %s [%s -> %s] %s\n",
-			cursor, module.Path, module.Version, module.Update.Version, indirect,
+			"%s %s %s %s [%s -> %s] %s %s\n",
+			cursor, checkbox, vulnMark, highlightMatches(module.Path, match.MatchedIndexes, m.color), module.Version, module.Update.Version, class, indirect,
 		))
 	}
 
 	return m.builder.String()
 }
 
+// visibleMatches returns the modules matching the current class filter and
+// fuzzy filter, in the order they should be rendered and navigated. With no
+// filters set, every module is "matched" so the full list is shown.
+func (m *model) visibleMatches() fuzzy.Matches {
+	indices := m.classFilteredIndices()
+
+	if m.filter == "" {
+		matches := make(fuzzy.Matches, len(indices))
+		for i, idx := range indices {
+			matches[i] = fuzzy.Match{Str: m.modules[idx].Path, Index: idx}
+		}
+		return matches
+	}
+
+	subset := make([]module, len(indices))
+	for i, idx := range indices {
+		subset[i] = m.modules[idx]
+	}
+
+	matches := fuzzy.FindFrom(m.filter, modulePaths(subset))
+	for i := range matches {
+		matches[i].Index = indices[matches[i].Index]
+	}
+
+	return matches
+}
+
+// classFilteredIndices returns the indices into m.modules that pass the
+// current class filter, or every index if no class filter is set.
+func (m *model) classFilteredIndices() []int {
+	if m.classFilter == "" {
+		indices := make([]int, len(m.modules))
+		for i := range m.modules {
+			indices[i] = i
+		}
+		return indices
+	}
+
+	indices := make([]int, 0, len(m.modules))
+	for i, module := range m.modules {
+		if module.Class == m.classFilter {
+			indices = append(indices, i)
+		}
+	}
+	return indices
+}
+
+type modulePaths []module
+
+func (p modulePaths) String(i int) string { return p[i].Path }
+func (p modulePaths) Len() int            { return len(p) }
+
+func highlightMatches(path string, matched []int, color termenv.Profile) string {
+	if len(matched) == 0 {
+		return path
+	}
+
+	highlighted := make(map[int]bool, len(matched))
+	for _, i := range matched {
+		highlighted[i] = true
+	}
+
+	var b strings.Builder
+	for i, r := range []rune(path) {
+		if highlighted[i] {
+			b.WriteString(termenv.String(string(r)).Foreground(color.Color("3")).String())
+		} else {
+			b.WriteRune(r)
+		}
+	}
+
+	return b.String()
+}
+
+func (m *model) selectedModules() []module {
+	selected := make([]module, 0)
+	for _, module := range m.modules {
+		if module.Selected {
+			selected = append(selected, module)
+		}
+	}
+
+	return selected
+}
+
+// toggleClassFilter restricts the visible modules to a single update class,
+// or clears the restriction if it's already active.
+func (m *model) toggleClassFilter(class string) {
+	if m.classFilter == class {
+		m.classFilter = ""
+	} else {
+		m.classFilter = class
+	}
+
+	m.cursor = 0
+	m.fixViewport(true)
+}
+
+// jumpToVulnerable moves the cursor forward to the next visible module with
+// known vulnerabilities, wrapping around. It does nothing if none are
+// affected.
+func (m *model) jumpToVulnerable() {
+	matches := m.visibleMatches()
+	if len(matches) == 0 {
+		return
+	}
+
+	for i := 1; i <= len(matches); i++ {
+		idx := (m.cursor + i) % len(matches)
+		if len(m.modules[matches[idx].Index].Vulns) > 0 {
+			m.cursor = idx
+			m.fixViewport(false)
+			return
+		}
+	}
+}
+
+func (m *model) clearSelection() {
+	for i := range m.modules {
+		m.modules[i].Selected = false
+	}
+}
+
 func (m *model) fixCursor() {
-	if m.cursor > len(m.modules)-1 {
+	n := len(m.visibleMatches())
+	if m.cursor > n-1 {
 		m.cursor = 0
 	} else if m.cursor < 0 {
-		m.cursor = len(m.modules) - 1
+		m.cursor = n - 1
 	}
 }
 
@@ -206,8 +739,28 @@ type (
 	modulesMsg struct {
 		modules []module
 	}
-	updatedMsg struct {
-		modules []module
+	updateStartedMsg struct {
+		cancel context.CancelFunc
+		lines  <-chan string
+		result <-chan updateResult
+	}
+	logLineMsg struct {
+		cancel context.CancelFunc
+		lines  <-chan string
+		result <-chan updateResult
+		line   string
+	}
+	updateStepMsg struct {
+		modules  []module
+		err      error
+		canceled bool
+	}
+	notesMsg struct {
+		path  string
+		notes string
+	}
+	vulnMsg struct {
+		vulns map[string][]Vulnerability // keyed by module path
 	}
 )
 
@@ -222,29 +775,352 @@ func loadCmd() tea.Cmd {
 	}
 }
 
-func updateCmd(m module) tea.Cmd {
+// updateCmd starts `go get -u` for the head of queue, streaming its combined
+// stdout/stderr line by line rather than blocking until it exits. It
+// snapshots go.mod/go.sum first so a ctrl+x cancellation (see listenForUpdate)
+// can restore them, turning a stuck module-proxy fetch into something the
+// user can see and interrupt instead of an opaque hang.
+func updateCmd(queue []module) tea.Cmd {
 	return func() tea.Msg {
-		cmd := exec.Command("go", "get", "-u", m.Update.Path+"@"+m.Update.Version)
-		err := cmd.Run()
+		snap, err := snapshotModFiles()
 		if err != nil {
 			return errMsg{err}
 		}
 
-		modules, err := load()
-		if err != nil {
+		mod := queue[0]
+		ctx, cancel := context.WithCancel(context.Background())
+
+		cmd := exec.CommandContext(ctx, "go", "get", "-u", mod.Update.Path+"@"+mod.Update.Version)
+
+		pr, pw := io.Pipe()
+		cmd.Stdout = pw
+		cmd.Stderr = pw
+
+		if err := cmd.Start(); err != nil {
+			cancel()
 			return errMsg{err}
 		}
 
-		return updatedMsg{modules}
+		lines := make(chan string)
+		result := make(chan updateResult, 1)
+
+		go func() {
+			scanner := bufio.NewScanner(pr)
+			for scanner.Scan() {
+				lines <- scanner.Text()
+			}
+			close(lines)
+		}()
+
+		go func() {
+			runErr := cmd.Wait()
+			pw.Close()
+
+			// Only treat this as a cancellation if the command actually
+			// failed while the context was done — checking ctx.Err() alone
+			// races the ctrl+x handler: a successful `go get` that finishes
+			// right as the user cancels would otherwise have its result
+			// silently discarded and go.mod/go.sum wrongly reverted.
+			canceled := runErr != nil && ctx.Err() != nil
+			if canceled {
+				if restoreErr := snap.restore(); restoreErr != nil {
+					result <- updateResult{err: restoreErr}
+					return
+				}
+			} else if runErr != nil {
+				result <- updateResult{err: runErr}
+				return
+			}
+
+			modules, loadErr := load()
+			if loadErr != nil {
+				result <- updateResult{err: loadErr}
+				return
+			}
+
+			result <- updateResult{modules: modules, canceled: canceled}
+		}()
+
+		return updateStartedMsg{cancel: cancel, lines: lines, result: result}
+	}
+}
+
+// updateResult is the terminal outcome of a streamed `go get`: either the
+// reloaded module list, or an error, or a report that ctrl+x canceled it
+// (in which case go.mod/go.sum have already been restored).
+type updateResult struct {
+	modules  []module
+	err      error
+	canceled bool
+}
+
+// listenForUpdate waits for either the next streamed output line or the
+// final result of the running `go get`, whichever comes first, and re-issues
+// itself to keep listening until the result arrives.
+func listenForUpdate(cancel context.CancelFunc, lines <-chan string, result <-chan updateResult) tea.Cmd {
+	return func() tea.Msg {
+		for {
+			select {
+			case line, ok := <-lines:
+				if !ok {
+					lines = nil // disable this case, keep waiting on result
+					continue
+				}
+				return logLineMsg{cancel: cancel, lines: lines, result: result, line: line}
+			case res := <-result:
+				return updateStepMsg{modules: res.modules, err: res.err, canceled: res.canceled}
+			}
+		}
 	}
 }
 
+// modFileSnapshot holds the pre-update contents of go.mod/go.sum so a
+// cancelled `go get` can restore them.
+type modFileSnapshot struct {
+	goMod []byte
+	goSum []byte // nil if go.sum didn't exist before the update
+}
+
+func snapshotModFiles() (modFileSnapshot, error) {
+	var (
+		snap modFileSnapshot
+		err  error
+	)
+
+	snap.goMod, err = os.ReadFile("go.mod")
+	if err != nil {
+		return modFileSnapshot{}, err
+	}
+
+	snap.goSum, err = os.ReadFile("go.sum")
+	if err != nil && !os.IsNotExist(err) {
+		return modFileSnapshot{}, err
+	}
+
+	return snap, nil
+}
+
+func (s modFileSnapshot) restore() error {
+	if err := os.WriteFile("go.mod", s.goMod, 0o644); err != nil {
+		return err
+	}
+
+	if s.goSum == nil {
+		// go.sum didn't exist before the update; remove one the update may
+		// have created so the tree actually matches its pre-update state.
+		if err := os.Remove("go.sum"); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return nil
+	}
+
+	return os.WriteFile("go.sum", s.goSum, 0o644)
+}
+
+// vulnCmd looks up known vulnerabilities for every module against the OSV
+// database. It runs once the module list has loaded, as a tea.Cmd that queries
+// all modules concurrently, so the UI stays responsive while they are in
+// flight.
+func vulnCmd(modules []module) tea.Cmd {
+	return func() tea.Msg {
+		var (
+			mu     sync.Mutex
+			wg     sync.WaitGroup
+			result = make(map[string][]Vulnerability, len(modules))
+		)
+
+		for _, m := range modules {
+			wg.Add(1)
+			go func(m module) {
+				defer wg.Done()
+
+				vulns := queryOSV(m.Path, m.Version)
+				if len(vulns) == 0 {
+					return
+				}
+
+				mu.Lock()
+				result[m.Path] = vulns
+				mu.Unlock()
+			}(m)
+		}
+		wg.Wait()
+
+		return vulnMsg{vulns: result}
+	}
+}
+
+type osvQuery struct {
+	Version string `json:"version"`
+	Package struct {
+		Name      string `json:"name"`
+		Ecosystem string `json:"ecosystem"`
+	} `json:"package"`
+}
+
+type osvVuln struct {
+	ID       string `json:"id"`
+	Severity []struct {
+		Type  string `json:"type"`
+		Score string `json:"score"`
+	} `json:"severity"`
+	Affected []struct {
+		Ranges []struct {
+			Events []struct {
+				Fixed string `json:"fixed"`
+			} `json:"events"`
+		} `json:"ranges"`
+	} `json:"affected"`
+}
+
+type osvResponse struct {
+	Vulns []osvVuln `json:"vulns"`
+}
+
+// queryOSV asks the OSV database (https://osv.dev) for vulnerabilities
+// affecting a module at a given version. It returns nil on any request
+// failure so a vulnerability lookup never blocks the rest of the UI.
+func queryOSV(path, version string) []Vulnerability {
+	query := osvQuery{Version: version}
+	query.Package.Name = path
+	query.Package.Ecosystem = "Go"
+
+	body, err := json.Marshal(query)
+	if err != nil {
+		return nil
+	}
+
+	resp, err := http.Post("https://api.osv.dev/v1/query", "application/json", bytes.NewReader(body))
+	if err != nil {
+		return nil
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil
+	}
+
+	var out osvResponse
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return nil
+	}
+
+	vulns := make([]Vulnerability, len(out.Vulns))
+	for i, v := range out.Vulns {
+		vulns[i] = Vulnerability{
+			ID:       v.ID,
+			Severity: osvSeverity(v),
+			FixedIn:  osvFixedIn(v),
+		}
+	}
+
+	return vulns
+}
+
+func osvSeverity(v osvVuln) string {
+	if len(v.Severity) == 0 {
+		return ""
+	}
+	return v.Severity[0].Score
+}
+
+func osvFixedIn(v osvVuln) string {
+	for _, affected := range v.Affected {
+		for _, r := range affected.Ranges {
+			for _, event := range r.Events {
+				if event.Fixed != "" {
+					return event.Fixed
+				}
+			}
+		}
+	}
+	return ""
+}
+
 type module struct {
 	Path     string  `json:"Path"`     // module path
 	Version  string  `json:"Version"`  // module version
 	Update   *module `json:"Update"`   // available update (with -u)
 	Main     bool    `json:"Main"`     // is this the main module?
 	Indirect bool    `json:"Indirect"` // module is only indirectly needed by main module
+
+	Selected bool // toggled in the TUI for bulk updates, not populated by `go list`
+
+	Notes        string // release notes between Version and Update.Version, fetched lazily
+	NotesLoading bool
+
+	Class string // semver-aware update class: major, minor, patch, or prerelease
+
+	Vulns []Vulnerability // known vulnerabilities affecting Version, fetched lazily
+}
+
+// Vulnerability is a known security advisory affecting a module version, as
+// reported by the OSV database.
+type Vulnerability struct {
+	ID       string // e.g. "GO-2023-1234"
+	Severity string
+	FixedIn  string // version the advisory was fixed in, if known
+}
+
+const (
+	classMajor      = "major"
+	classMinor      = "minor"
+	classPatch      = "patch"
+	classPrerelease = "prerelease"
+)
+
+// classifyUpdate reports how m.Update compares to m.Version: major, minor,
+// patch, or prerelease. It returns "" when m.Update is nil or either version
+// isn't valid semver. Pseudo-versions are valid semver; an update to one is
+// classed as prerelease.
+func classifyUpdate(m module) string {
+	if m.Update == nil || !semver.IsValid(m.Version) || !semver.IsValid(m.Update.Version) {
+		return ""
+	}
+
+	switch {
+	case semver.Prerelease(m.Update.Version) != "":
+		return classPrerelease
+	case semver.Major(m.Version) != semver.Major(m.Update.Version):
+		return classMajor
+	case semver.MajorMinor(m.Version) != semver.MajorMinor(m.Update.Version):
+		return classMinor
+	default:
+		return classPatch
+	}
+}
+
+// colorizeClass renders an update class as a colored tag: red for major,
+// yellow for minor, green for patch.
+func colorizeClass(class string, color termenv.Profile) string {
+	code := "7"
+	switch class {
+	case classMajor:
+		code = "1"
+	case classMinor:
+		code = "3"
+	case classPatch:
+		code = "2"
+	}
+
+	return termenv.String("[" + class + "]").Foreground(color.Color(code)).String()
+}
+
+// filterByClass keeps only the modules whose update class is in allowed.
+// With allowed == nil (no --only flag given), modules is returned as-is.
+func filterByClass(modules []module, allowed map[string]bool) []module {
+	if allowed == nil {
+		return modules
+	}
+
+	filtered := make([]module, 0, len(modules))
+	for _, m := range modules {
+		if allowed[m.Class] {
+			filtered = append(filtered, m)
+		}
+	}
+
+	return filtered
 }
 
 func load() ([]module, error) {
@@ -270,6 +1146,7 @@ func load() ([]module, error) {
 		}
 
 		if !m.Main && m.Update != nil {
+			m.Class = classifyUpdate(m)
 			modules = append(modules, m)
 		}
 	}
@@ -285,3 +1162,230 @@ func load() ([]module, error) {
 
 	return modules, nil
 }
+
+// notesCacheCap bounds the in-memory release-notes cache so a long-running
+// session doesn't keep every fetched changelog around forever.
+const notesCacheCap = 64
+
+var (
+	notesCacheMu    sync.Mutex
+	notesCacheItems = make(map[string]*list.Element)
+	notesCacheOrder = list.New()
+)
+
+type notesCacheEntry struct {
+	key   string
+	notes string
+}
+
+func notesCacheGet(key string) (string, bool) {
+	notesCacheMu.Lock()
+	defer notesCacheMu.Unlock()
+
+	el, ok := notesCacheItems[key]
+	if !ok {
+		return "", false
+	}
+
+	notesCacheOrder.MoveToFront(el)
+	return el.Value.(*notesCacheEntry).notes, true
+}
+
+func notesCacheSet(key, notes string) {
+	notesCacheMu.Lock()
+	defer notesCacheMu.Unlock()
+
+	if el, ok := notesCacheItems[key]; ok {
+		el.Value.(*notesCacheEntry).notes = notes
+		notesCacheOrder.MoveToFront(el)
+		return
+	}
+
+	notesCacheItems[key] = notesCacheOrder.PushFront(&notesCacheEntry{key: key, notes: notes})
+
+	if notesCacheOrder.Len() > notesCacheCap {
+		oldest := notesCacheOrder.Back()
+		notesCacheOrder.Remove(oldest)
+		delete(notesCacheItems, oldest.Value.(*notesCacheEntry).key)
+	}
+}
+
+// releasesCmd fetches the release notes between m.Version and
+// m.Update.Version, serving them from the in-memory cache when possible.
+func releasesCmd(m module) tea.Cmd {
+	return func() tea.Msg {
+		key := m.Path + "@" + m.Version + ".." + m.Update.Version
+		if notes, ok := notesCacheGet(key); ok {
+			return notesMsg{path: m.Path, notes: notes}
+		}
+
+		notes := fetchReleaseNotes(m.Path, m.Version, m.Update.Version)
+		notesCacheSet(key, notes)
+
+		return notesMsg{path: m.Path, notes: notes}
+	}
+}
+
+// fetchReleaseNotes looks up the changelog between fromVer and toVer for a
+// GitHub- or GitLab-hosted module. It falls back to an empty string
+// (rendered as "no notes available") for private repositories, unknown
+// hosts, or any request failure.
+func fetchReleaseNotes(path, fromVer, toVer string) string {
+	host, owner, repo, ok := splitHostedRepo(path)
+	if !ok {
+		return ""
+	}
+
+	switch host {
+	case "github.com":
+		return fetchGitHubNotes(owner, repo, fromVer, toVer)
+	case "gitlab.com":
+		return fetchGitLabNotes(owner, repo, fromVer, toVer)
+	default:
+		return ""
+	}
+}
+
+// splitHostedRepo extracts the host, owner and repo from a module path such
+// as "github.com/owner/repo" or "gitlab.com/owner/repo/v2". It reports ok =
+// false for paths that don't look like a hosted repository.
+func splitHostedRepo(path string) (host, owner, repo string, ok bool) {
+	parts := strings.SplitN(path, "/", 4)
+	if len(parts) < 3 {
+		return "", "", "", false
+	}
+
+	return parts[0], parts[1], parts[2], true
+}
+
+type release struct {
+	tag  string
+	body string
+}
+
+func fetchGitHubNotes(owner, repo, fromVer, toVer string) string {
+	var releases []struct {
+		TagName string `json:"tag_name"`
+		Body    string `json:"body"`
+	}
+
+	headers := http.Header{"Accept": []string{"application/vnd.github+json"}}
+	if token := os.Getenv("GITHUB_TOKEN"); token != "" {
+		headers.Set("Authorization", "Bearer "+token)
+	}
+
+	apiURL := fmt.Sprintf("https://api.github.com/repos/%s/%s/releases", owner, repo)
+	if !getJSON(apiURL, headers, &releases) {
+		return ""
+	}
+
+	tagged := make([]release, len(releases))
+	for i, r := range releases {
+		tagged[i] = release{tag: r.TagName, body: r.Body}
+	}
+
+	return joinReleaseNotes(tagged, fromVer, toVer)
+}
+
+func fetchGitLabNotes(owner, repo, fromVer, toVer string) string {
+	var releases []struct {
+		TagName     string `json:"tag_name"`
+		Description string `json:"description"`
+	}
+
+	project := url.QueryEscape(owner + "/" + repo)
+	apiURL := fmt.Sprintf("https://gitlab.com/api/v4/projects/%s/releases", project)
+	if !getJSON(apiURL, http.Header{}, &releases) {
+		return ""
+	}
+
+	tagged := make([]release, len(releases))
+	for i, r := range releases {
+		tagged[i] = release{tag: r.TagName, body: r.Description}
+	}
+
+	return joinReleaseNotes(tagged, fromVer, toVer)
+}
+
+// getJSON performs a GET request and decodes a JSON body into v. It reports
+// false on any network, status, or decode error so callers can fall back to
+// "no notes available" instead of surfacing an errMsg for a best-effort
+// pane.
+func getJSON(rawURL string, headers http.Header, v interface{}) bool {
+	req, err := http.NewRequest(http.MethodGet, rawURL, nil)
+	if err != nil {
+		return false
+	}
+	req.Header = headers
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return false
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return false
+	}
+
+	return json.NewDecoder(resp.Body).Decode(v) == nil
+}
+
+// joinReleaseNotes concatenates the bodies of every release tagged strictly
+// after fromVer and up to and including toVer, oldest first.
+func joinReleaseNotes(releases []release, fromVer, toVer string) string {
+	var matched []release
+	for _, r := range releases {
+		if compareVersions(r.tag, fromVer) > 0 && compareVersions(r.tag, toVer) <= 0 {
+			matched = append(matched, r)
+		}
+	}
+
+	sort.Slice(matched, func(i, j int) bool {
+		return compareVersions(matched[i].tag, matched[j].tag) < 0
+	})
+
+	var b strings.Builder
+	for _, r := range matched {
+		if b.Len() > 0 {
+			b.WriteString("\n\n")
+		}
+		fmt.Fprintf(&b, "## %s\n\n%s", r.tag, strings.TrimSpace(r.body))
+	}
+
+	return b.String()
+}
+
+// compareVersions does a best-effort numeric comparison of two semver-ish
+// tags (e.g. "v1.2.3"), ignoring any pre-release/build metadata. It's just
+// enough to order and filter releases for the notes pane.
+func compareVersions(a, b string) int {
+	core := func(v string) string {
+		v = strings.TrimPrefix(v, "v")
+		if i := strings.IndexAny(v, "-+"); i >= 0 {
+			v = v[:i]
+		}
+		return v
+	}
+
+	sa := strings.Split(core(a), ".")
+	sb := strings.Split(core(b), ".")
+
+	for i := 0; i < len(sa) || i < len(sb); i++ {
+		var na, nb int
+		if i < len(sa) {
+			na, _ = strconv.Atoi(sa[i])
+		}
+		if i < len(sb) {
+			nb, _ = strconv.Atoi(sb[i])
+		}
+		if na != nb {
+			if na < nb {
+				return -1
+			}
+			return 1
+		}
+	}
+
+	return 0
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func testModules() []module {
+	return []module{
+		{Path: "github.com/foo/bar", Version: "v1.2.3", Update: &module{Version: "v1.3.0"}, Class: classMinor},
+		{Path: "github.com/foo/baz", Version: "v2.0.0", Update: &module{Version: "v3.0.0"}, Class: classMajor, Indirect: true},
+	}
+}
+
+func TestWriteJSONReport(t *testing.T) {
+	var b strings.Builder
+	if err := writeJSONReport(&b, testModules()); err != nil {
+		t.Fatalf("writeJSONReport: %v", err)
+	}
+
+	out := b.String()
+	if !strings.Contains(out, `"Path": "github.com/foo/bar"`) {
+		t.Errorf("output missing expected module path:\n%s", out)
+	}
+	if !strings.Contains(out, `"Class": "major"`) {
+		t.Errorf("output missing expected class:\n%s", out)
+	}
+}
+
+func TestWriteMarkdownReport(t *testing.T) {
+	var b strings.Builder
+	if err := writeMarkdownReport(&b, testModules()); err != nil {
+		t.Fatalf("writeMarkdownReport: %v", err)
+	}
+
+	out := b.String()
+	if !strings.HasPrefix(out, "| Module | Version | Update | Class | Indirect |\n") {
+		t.Errorf("output missing table header:\n%s", out)
+	}
+	if !strings.Contains(out, "| github.com/foo/bar | v1.2.3 | v1.3.0 | minor | false |") {
+		t.Errorf("output missing expected row:\n%s", out)
+	}
+}
+
+func TestWriteMarkdownReportEmpty(t *testing.T) {
+	var b strings.Builder
+	if err := writeMarkdownReport(&b, nil); err != nil {
+		t.Fatalf("writeMarkdownReport: %v", err)
+	}
+
+	if got := b.String(); got != "All modules are up-to-date.\n" {
+		t.Errorf("got %q, want up-to-date message", got)
+	}
+}
+
+func TestWriteTableReport(t *testing.T) {
+	var b strings.Builder
+	if err := writeTableReport(&b, testModules()); err != nil {
+		t.Fatalf("writeTableReport: %v", err)
+	}
+
+	out := b.String()
+	if !strings.Contains(out, "MODULE") || !strings.Contains(out, "github.com/foo/baz") {
+		t.Errorf("output missing expected content:\n%s", out)
+	}
+}
+
+func TestExcludeIndirect(t *testing.T) {
+	got := excludeIndirect(testModules())
+	if len(got) != 1 || got[0].Path != "github.com/foo/bar" {
+		t.Errorf("got %+v, want only the direct dependency", got)
+	}
+}